@@ -0,0 +1,275 @@
+package iterator
+
+import "sync"
+
+const (
+	// ErrInvalidChunkSize is reported by Chunk's Iterator when size is not
+	// positive.
+	ErrInvalidChunkSize Error = "iterator: chunk size must be positive"
+	// ErrInvalidWindowSize is reported by Window's Iterator when size is
+	// not positive.
+	ErrInvalidWindowSize Error = "iterator: window size must be positive"
+	// ErrInvalidWindowStep is reported by Window's Iterator when step is
+	// not positive.
+	ErrInvalidWindowStep Error = "iterator: window step must be positive"
+)
+
+type chunkIter[E any] struct {
+	src  Iterator[E]
+	size int
+
+	cur  []E
+	done bool
+}
+
+// Chunk groups the elements of iter into non-overlapping slices of size
+// elements. The final chunk is shorter than size if the source does not
+// divide evenly; an empty source produces no chunks at all. A non-positive
+// size is invalid: the returned Iterator produces no chunks and reports
+// ErrInvalidChunkSize from Err.
+func Chunk[E any](iter Iterator[E], size int) Iterator[[]E] {
+	if size <= 0 {
+		return &erroredIter[[]E]{err: ErrInvalidChunkSize}
+	}
+	return &chunkIter[E]{src: iter, size: size}
+}
+
+func (c *chunkIter[E]) Next() bool {
+	if c.done {
+		return false
+	}
+	c.cur = make([]E, 0, c.size)
+	for len(c.cur) < c.size {
+		if !c.src.Next() {
+			c.done = true
+			break
+		}
+		c.cur = append(c.cur, c.src.Get())
+	}
+	if len(c.cur) == 0 {
+		return false
+	}
+	if c.done && c.src.Err() != nil {
+		// The source stopped short of a full chunk because it errored, not
+		// because it was cleanly exhausted. Per the Iterator contract, Next
+		// must not return true while Err is non-nil, so the incomplete
+		// chunk is dropped rather than surfaced as a successful result.
+		c.cur = nil
+		return false
+	}
+	return true
+}
+
+func (c *chunkIter[E]) Get() []E {
+	return c.cur
+}
+
+func (c *chunkIter[E]) Err() error {
+	return c.src.Err()
+}
+
+// ringBuffer is a fixed-capacity circular buffer used by Window to hold the
+// current window without reallocating or shifting elements on every slide.
+type ringBuffer[E any] struct {
+	data  []E
+	start int
+	count int
+}
+
+func newRingBuffer[E any](capacity int) *ringBuffer[E] {
+	return &ringBuffer[E]{data: make([]E, capacity)}
+}
+
+func (r *ringBuffer[E]) push(v E) {
+	if r.count < len(r.data) {
+		r.data[(r.start+r.count)%len(r.data)] = v
+		r.count++
+		return
+	}
+	r.data[r.start] = v
+	r.start = (r.start + 1) % len(r.data)
+}
+
+func (r *ringBuffer[E]) drop(n int) {
+	if n > r.count {
+		n = r.count
+	}
+	r.start = (r.start + n) % len(r.data)
+	r.count -= n
+}
+
+func (r *ringBuffer[E]) snapshot() []E {
+	out := make([]E, r.count)
+	for i := 0; i < r.count; i++ {
+		out[i] = r.data[(r.start+i)%len(r.data)]
+	}
+	return out
+}
+
+type windowIter[E any] struct {
+	src  Iterator[E]
+	size int
+	step int
+	buf  *ringBuffer[E]
+
+	started bool
+	done    bool
+	cur     []E
+}
+
+// Window produces slices of size elements from iter, each one
+// step elements further along than the last. A step smaller than size
+// yields overlapping windows; a step larger than size skips the elements
+// strictly between windows without ever buffering them. Iteration stops as
+// soon as iter cannot fill a complete window. A non-positive size or step
+// is invalid: the returned Iterator produces no windows and reports
+// ErrInvalidWindowSize or ErrInvalidWindowStep from Err.
+func Window[E any](iter Iterator[E], size, step int) Iterator[[]E] {
+	if size <= 0 {
+		return &erroredIter[[]E]{err: ErrInvalidWindowSize}
+	}
+	if step <= 0 {
+		return &erroredIter[[]E]{err: ErrInvalidWindowStep}
+	}
+	return &windowIter[E]{src: iter, size: size, step: step, buf: newRingBuffer[E](size)}
+}
+
+func (w *windowIter[E]) fill(n int) bool {
+	for i := 0; i < n; i++ {
+		if !w.src.Next() {
+			return false
+		}
+		w.buf.push(w.src.Get())
+	}
+	return true
+}
+
+func (w *windowIter[E]) Next() bool {
+	if w.done {
+		return false
+	}
+	if !w.started {
+		w.started = true
+		if !w.fill(w.size) {
+			w.done = true
+			return false
+		}
+	} else {
+		drop := w.step
+		if drop > w.size {
+			for i := 0; i < drop-w.size; i++ {
+				if !w.src.Next() {
+					w.done = true
+					return false
+				}
+			}
+			drop = w.size
+		}
+		w.buf.drop(drop)
+		if !w.fill(drop) {
+			w.done = true
+			return false
+		}
+	}
+	w.cur = w.buf.snapshot()
+	return true
+}
+
+func (w *windowIter[E]) Get() []E {
+	return w.cur
+}
+
+func (w *windowIter[E]) Err() error {
+	return w.src.Err()
+}
+
+// teeShared is the state shared by every Iterator returned from a single
+// Tee call: the source iterator, the buffered backlog of elements that have
+// been read from it but not yet seen by every consumer, and each consumer's
+// read cursor.
+type teeShared[E any] struct {
+	mu   sync.Mutex
+	src  Iterator[E]
+	err  error
+	done bool
+
+	queue     []E
+	base      int
+	positions []int
+}
+
+func (s *teeShared[E]) compact() {
+	min := s.positions[0]
+	for _, p := range s.positions[1:] {
+		if p < min {
+			min = p
+		}
+	}
+	if drop := min - s.base; drop > 0 {
+		s.queue = s.queue[drop:]
+		s.base = min
+	}
+}
+
+type teeIter[E any] struct {
+	shared *teeShared[E]
+	id     int
+	cur    E
+	ended  bool
+}
+
+// Tee splits iter into n independent, single-pass iterators. The source is
+// read by whichever returned iterator is currently furthest ahead; elements
+// are buffered only long enough for the slowest consumer to catch up, and
+// are dropped from the shared backlog once every consumer has seen them.
+// iter itself must not be used again after being passed to Tee.
+func Tee[E any](iter Iterator[E], n int) []Iterator[E] {
+	shared := &teeShared[E]{src: iter, positions: make([]int, n)}
+	out := make([]Iterator[E], n)
+	for i := range out {
+		out[i] = &teeIter[E]{shared: shared, id: i}
+	}
+	return out
+}
+
+func (t *teeIter[E]) Next() bool {
+	s := t.shared
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pos := s.positions[t.id]
+	idx := pos - s.base
+	for idx >= len(s.queue) {
+		if s.done {
+			t.ended = true
+			return false
+		}
+		if !s.src.Next() {
+			s.err = s.src.Err()
+			s.done = true
+			t.ended = true
+			return false
+		}
+		s.queue = append(s.queue, s.src.Get())
+	}
+	t.cur = s.queue[idx]
+	s.positions[t.id] = pos + 1
+	s.compact()
+	return true
+}
+
+func (t *teeIter[E]) Get() E {
+	return t.cur
+}
+
+func (t *teeIter[E]) Err() error {
+	if !t.ended {
+		// The source's error applies to this consumer only once it has read
+		// every element buffered ahead of the failure.
+		return nil
+	}
+	s := t.shared
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
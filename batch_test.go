@@ -0,0 +1,220 @@
+package iterator
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// errAfterIter yields each of vals in order, then fails with err once they
+// are exhausted, instead of stopping cleanly.
+type errAfterIter[E any] struct {
+	vals []E
+	err  error
+
+	idx    int
+	cur    E
+	failed bool
+}
+
+func (e *errAfterIter[E]) Next() bool {
+	if e.failed {
+		return false
+	}
+	if e.idx >= len(e.vals) {
+		e.failed = true
+		return false
+	}
+	e.cur = e.vals[e.idx]
+	e.idx++
+	return true
+}
+
+func (e *errAfterIter[E]) Get() E {
+	return e.cur
+}
+
+func (e *errAfterIter[E]) Err() error {
+	if e.failed {
+		return e.err
+	}
+	return nil
+}
+
+func TestChunk(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3, 4, 5}}
+	got, err := ToSlice[[]int](Chunk[int](src, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestChunkErrorDropsTrailingPartial(t *testing.T) {
+	boom := errors.New("boom")
+	src := &errAfterIter[int]{vals: []int{1, 2, 3, 4, 5}, err: boom}
+	got, err := ToSlice[[]int](Chunk[int](src, 2))
+	if !errors.Is(err, boom) {
+		t.Fatalf("got err %v, want %v", err, boom)
+	}
+	want := [][]int{{1, 2}, {3, 4}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3}}
+	got, err := ToSlice[[]int](Chunk[int](src, 0))
+	if !errors.Is(err, ErrInvalidChunkSize) {
+		t.Fatalf("got err %v, want %v", err, ErrInvalidChunkSize)
+	}
+	if len(got) != 0 {
+		t.Fatalf("got %v, want no chunks", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3, 4, 5}}
+	got, err := ToSlice[[]int](Window[int](src, 3, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestWindowInvalidSizeAndStep(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3}}
+	if _, err := ToSlice[[]int](Window[int](src, 0, 1)); !errors.Is(err, ErrInvalidWindowSize) {
+		t.Fatalf("got err %v, want %v", err, ErrInvalidWindowSize)
+	}
+	src = &Slice[int]{Slice: []int{1, 2, 3}}
+	if _, err := ToSlice[[]int](Window[int](src, 2, 0)); !errors.Is(err, ErrInvalidWindowStep) {
+		t.Fatalf("got err %v, want %v", err, ErrInvalidWindowStep)
+	}
+}
+
+func TestTee(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3}}
+	tees := Tee[int](src, 2)
+	got0, err := ToSlice[int](tees[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	got1, err := ToSlice[int](tees[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{1, 2, 3}
+	if diff := cmp.Diff(got0, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+	if diff := cmp.Diff(got1, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+// TestTeeConcurrentConsumersAtDifferentSpeeds exercises the invariant TestTee
+// never touches: consumers draining concurrently, at different speeds, must
+// each still see every element in order, with the shared backlog advancing
+// the source only for whoever is furthest ahead and compacting once the
+// slowest consumer has seen an element.
+func TestTeeConcurrentConsumersAtDifferentSpeeds(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}
+	tees := Tee[int](src, 3)
+	delays := []time.Duration{0, time.Millisecond, 2 * time.Millisecond}
+
+	results := make([][]int, len(tees))
+	var wg sync.WaitGroup
+	wg.Add(len(tees))
+	for i, it := range tees {
+		go func(i int, it Iterator[int]) {
+			defer wg.Done()
+			for it.Next() {
+				results[i] = append(results[i], it.Get())
+				time.Sleep(delays[i])
+			}
+			if err := it.Err(); err != nil {
+				t.Error(err)
+			}
+		}(i, it)
+	}
+	wg.Wait()
+
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for i, got := range results {
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Fatalf("tee %d mismatch (-got, +want): %s", i, diff)
+		}
+	}
+}
+
+// TestTeeCompactsOnceSlowestConsumerCatchesUp drives consumers out of
+// lockstep directly, so the shared backlog's state can be asserted at each
+// step: it must retain elements a faster consumer has already seen until
+// the slowest consumer has seen them too, and drop them as soon as it does.
+func TestTeeCompactsOnceSlowestConsumerCatchesUp(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3, 4, 5}}
+	tees := Tee[int](src, 3)
+	shared := tees[0].(*teeIter[int]).shared
+
+	for i := 0; i < 2; i++ {
+		if !tees[0].Next() {
+			t.Fatalf("tee 0 step %d: Next() = false, err %v", i, tees[0].Err())
+		}
+	}
+	if got, want := len(shared.queue), 2; got != want {
+		t.Fatalf("queue len = %d, want %d (nothing dropped until every consumer catches up)", got, want)
+	}
+
+	if !tees[1].Next() {
+		t.Fatalf("tee 1: Next() = false, err %v", tees[1].Err())
+	}
+	if got, want := shared.base, 0; got != want {
+		t.Fatalf("base = %d, want %d (tee 2 hasn't seen element 1 yet)", got, want)
+	}
+
+	if !tees[2].Next() {
+		t.Fatalf("tee 2: Next() = false, err %v", tees[2].Err())
+	}
+	if got, want := shared.base, 1; got != want {
+		t.Fatalf("base = %d, want %d (every consumer has now seen element 1)", got, want)
+	}
+	if got, want := len(shared.queue), 1; got != want {
+		t.Fatalf("queue len = %d, want %d (element 1 should be compacted away)", got, want)
+	}
+}
+
+// TestTeeErrorSurfacesToAllConsumers confirms a source error reaches every
+// tee'd consumer, not just whichever happens to read past the end first.
+func TestTeeErrorSurfacesToAllConsumers(t *testing.T) {
+	boom := errors.New("boom")
+	src := &errAfterIter[int]{vals: []int{1, 2, 3}, err: boom}
+	tees := Tee[int](src, 2)
+
+	got0, err0 := ToSlice[int](tees[0])
+	got1, err1 := ToSlice[int](tees[1])
+
+	want := []int{1, 2, 3}
+	if diff := cmp.Diff(got0, want); diff != "" {
+		t.Fatalf("tee 0 mismatch (-got, +want): %s", diff)
+	}
+	if diff := cmp.Diff(got1, want); diff != "" {
+		t.Fatalf("tee 1 mismatch (-got, +want): %s", diff)
+	}
+	if !errors.Is(err0, boom) {
+		t.Fatalf("tee 0 got err %v, want %v", err0, boom)
+	}
+	if !errors.Is(err1, boom) {
+		t.Fatalf("tee 1 got err %v, want %v", err1, boom)
+	}
+}
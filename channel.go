@@ -53,22 +53,36 @@ func (ci *channelIter[E]) Next() bool {
 	if ci.item.Err != nil {
 		return false
 	}
+	// Give already-buffered (or already-closed) data on ci.c priority over
+	// ci.ctx.Done(): once a producer is finished it may cancel ci.ctx while
+	// ci.c still holds unread items, and a single select with both cases
+	// ready would pick between them at random, silently dropping trailing
+	// elements on an otherwise clean run.
 	select {
 	case item, open := <-ci.c:
-		if !open {
-			return false
-		}
-		ci.item = item
-		if ci.item.Err != nil {
-			ci.cancel()
-		}
-		return ci.item.Err == nil
+		return ci.consume(item, open)
+	default:
+	}
+	select {
+	case item, open := <-ci.c:
+		return ci.consume(item, open)
 	case <-ci.ctx.Done():
 		ci.item.Err = ci.ctx.Err()
 		return false
 	}
 }
 
+func (ci *channelIter[E]) consume(item item[E], open bool) bool {
+	if !open {
+		return false
+	}
+	ci.item = item
+	if ci.item.Err != nil {
+		ci.cancel()
+	}
+	return ci.item.Err == nil
+}
+
 func (ci *channelIter[E]) Get() E {
 	return ci.item.Data
 }
@@ -206,3 +220,69 @@ func MapAsync[BEFORE any, AFTER any](iter Iterator[BEFORE], update func(BEFORE)
 		c: out,
 	}
 }
+
+// groupPartitionBuffer is the channel capacity given to each nested iterator
+// produced by Group. A buffer lets the producer stay a little ahead of a
+// partition's consumer without blocking, but does not remove the need to
+// drain every partition.
+const groupPartitionBuffer = 16
+
+// Group fans a single iterator into one nested Iterator per distinct key.
+// A single goroutine reads iter and, the first time a key is seen, emits a
+// Pair on the returned outer iterator pairing that key with a fresh nested
+// Iterator; every later element sharing the key is fed into that same nested
+// iterator.
+//
+// Deadlock hazard: the producer goroutine is single-threaded and feeds all
+// partitions from one loop. If a caller does not drain every emitted nested
+// iterator concurrently (including the outer one), the producer can block
+// forever writing to a full or undrained partition, starving the rest,
+// including partitions the caller is actively trying to read. Group takes
+// ctx for exactly this reason: there is no context.Background()-only entry
+// point to reach for by mistake. Cancel ctx to release a producer stuck on
+// an abandoned partition instead of hanging forever.
+func Group[E any, K comparable](ctx context.Context, src Iterator[E], key func(E) (K, error)) Iterator[Pair[K, Iterator[E]]] {
+	groupCtx, cancel := context.WithCancel(ctx)
+	outer := &channelIter[Pair[K, Iterator[E]]]{
+		ctx:    groupCtx,
+		cancel: cancel,
+		c:      make(chan item[Pair[K, Iterator[E]]]),
+	}
+	go func() {
+		defer cancel()
+		defer close(outer.c)
+		partitions := make(map[K]*channelIter[E])
+		defer func() {
+			for _, p := range partitions {
+				close(p.c)
+			}
+		}()
+		for src.Next() {
+			k, err := key(src.Get())
+			if err != nil {
+				outer.SendErr(err)
+				return
+			}
+			p, ok := partitions[k]
+			if !ok {
+				pCtx, pCancel := context.WithCancel(groupCtx)
+				p = &channelIter[E]{
+					ctx:    pCtx,
+					cancel: pCancel,
+					c:      make(chan item[E], groupPartitionBuffer),
+				}
+				partitions[k] = p
+				if outer.Send(Pair[K, Iterator[E]]{Key: k, Value: p}) {
+					return
+				}
+			}
+			if p.Send(src.Get()) {
+				return
+			}
+		}
+		if err := src.Err(); err != nil {
+			outer.SendErr(err)
+		}
+	}()
+	return outer
+}
@@ -0,0 +1,96 @@
+package iterator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGroup(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 1, 3, 2, 1}}
+	outer := Group[int, int](context.Background(), src, func(v int) (int, error) {
+		return v % 2, nil
+	})
+
+	// The documented usage: drain every nested partition concurrently with
+	// the outer iterator and with each other, never fully draining one
+	// before the others have a chance to make progress.
+	got := map[int][]int{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for outer.Next() {
+		pair := outer.Get()
+		wg.Add(1)
+		go func(key int, nested Iterator[int]) {
+			defer wg.Done()
+			vals, err := ToSlice[int](nested)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			got[key] = vals
+			mu.Unlock()
+		}(pair.Key, pair.Value)
+	}
+	wg.Wait()
+	if err := outer.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[int][]int{
+		1: {1, 1, 3, 1},
+		0: {2, 2},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+// TestGroupAbandonedPartitionNeedsCancel documents and exercises the
+// deadlock hazard described on Group: the producer is single-threaded, so
+// fully draining the first partition before the second key's first element
+// has even been produced requires the producer to get past handing that
+// second partition to the outer iterator first - which the caller isn't
+// doing yet. Without a cancelable context there would be no way out of
+// this; this test uses one to recover within its own timeout instead of
+// hanging forever.
+func TestGroupAbandonedPartitionNeedsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &Slice[int]{Slice: []int{1, 2, 1, 3, 2, 1}}
+	outer := Group[int, int](ctx, src, func(v int) (int, error) {
+		return v % 2, nil
+	})
+
+	if !outer.Next() {
+		t.Fatal("expected at least one partition")
+	}
+	first := outer.Get()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := ToSlice[int](first.Value)
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected draining the first partition to block on the stalled producer")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected Err() to report the cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("draining the partition did not return after cancel")
+	}
+}
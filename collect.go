@@ -0,0 +1,143 @@
+package iterator
+
+import "sort"
+
+type distinctIter[E comparable] struct {
+	src  Iterator[E]
+	seen map[E]struct{}
+	cur  E
+}
+
+// Distinct lazily filters iter down to the first occurrence of each value,
+// using a hash set keyed on E to recognize repeats. The set grows for as
+// long as the source keeps producing new values.
+func Distinct[E comparable](iter Iterator[E]) Iterator[E] {
+	return &distinctIter[E]{src: iter, seen: make(map[E]struct{})}
+}
+
+func (d *distinctIter[E]) Next() bool {
+	for d.src.Next() {
+		v := d.src.Get()
+		if _, ok := d.seen[v]; ok {
+			continue
+		}
+		d.seen[v] = struct{}{}
+		d.cur = v
+		return true
+	}
+	return false
+}
+
+func (d *distinctIter[E]) Get() E {
+	return d.cur
+}
+
+func (d *distinctIter[E]) Err() error {
+	return d.src.Err()
+}
+
+// erroredIter is an Iterator that yields nothing and reports err from Err.
+// It lets a terminal operator that fails while draining its source still
+// return an Iterator rather than needing a second error-returning signature.
+type erroredIter[E any] struct {
+	err error
+}
+
+func (e *erroredIter[E]) Next() bool {
+	return false
+}
+
+func (e *erroredIter[E]) Get() E {
+	var zero E
+	return zero
+}
+
+func (e *erroredIter[E]) Err() error {
+	return e.err
+}
+
+// SortBy is a terminal operator: it drains iter completely, sorts the
+// result with less, and returns a Slice-backed Iterator over the sorted
+// values. If iter errors while draining, the returned Iterator produces no
+// values and reports that error from Err.
+func SortBy[E any](iter Iterator[E], less func(a, b E) bool) Iterator[E] {
+	all, err := ToSlice(iter)
+	if err != nil {
+		return &erroredIter[E]{err: err}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return less(all[i], all[j])
+	})
+	return &Slice[E]{Slice: all}
+}
+
+type groupAdjacentIter[E any, K comparable] struct {
+	src Iterator[E]
+	key func(E) K
+
+	hasNext bool
+	nextVal E
+	nextKey K
+
+	cur  Pair[K, []E]
+	done bool
+}
+
+// GroupAdjacent collects runs of consecutive elements that share the same
+// key into one Pair per run. It does not hold the whole stream in memory,
+// only the current run: a run is emitted, and its backing slice
+// discarded, as soon as a differently-keyed element is seen.
+func GroupAdjacent[E any, K comparable](iter Iterator[E], key func(E) K) Iterator[Pair[K, []E]] {
+	return &groupAdjacentIter[E, K]{src: iter, key: key}
+}
+
+func (g *groupAdjacentIter[E, K]) Next() bool {
+	if g.done {
+		return false
+	}
+	if !g.hasNext {
+		if !g.src.Next() {
+			g.done = true
+			return false
+		}
+		g.nextVal = g.src.Get()
+		g.nextKey = g.key(g.nextVal)
+		g.hasNext = true
+	}
+
+	k := g.nextKey
+	run := []E{g.nextVal}
+	g.hasNext = false
+	for g.src.Next() {
+		v := g.src.Get()
+		vk := g.key(v)
+		if vk != k {
+			g.nextVal = v
+			g.nextKey = vk
+			g.hasNext = true
+			break
+		}
+		run = append(run, v)
+	}
+	if !g.hasNext {
+		g.done = true
+		if g.src.Err() != nil {
+			// The source stopped mid-run because it errored, not because it
+			// was cleanly exhausted. Per the Iterator contract, Next must not
+			// return true while Err is non-nil, so the in-progress run is
+			// dropped rather than surfaced as a successful result.
+			g.cur = Pair[K, []E]{}
+			return false
+		}
+	}
+	g.cur = Pair[K, []E]{Key: k, Value: run}
+	return true
+}
+
+func (g *groupAdjacentIter[E, K]) Get() Pair[K, []E] {
+	return g.cur
+}
+
+func (g *groupAdjacentIter[E, K]) Err() error {
+	return g.src.Err()
+}
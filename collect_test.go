@@ -0,0 +1,63 @@
+package iterator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDistinct(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 2, 3, 1, 4}}
+	got, err := ToSlice[int](Distinct[int](src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{1, 2, 3, 4}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	src := &Slice[int]{Slice: []int{3, 1, 2}}
+	got, err := ToSlice[int](SortBy[int](src, func(a, b int) bool { return a < b }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{1, 2, 3}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestGroupAdjacent(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 1, 2, 2, 2, 1}}
+	got, err := ToSlice[Pair[int, []int]](GroupAdjacent[int, int](src, func(v int) int { return v }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Pair[int, []int]{
+		{Key: 1, Value: []int{1, 1}},
+		{Key: 2, Value: []int{2, 2, 2}},
+		{Key: 1, Value: []int{1}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestGroupAdjacentErrorDropsTrailingRun(t *testing.T) {
+	boom := errors.New("boom")
+	src := &errAfterIter[int]{vals: []int{1, 1, 2, 2, 2}, err: boom}
+	got, err := ToSlice[Pair[int, []int]](GroupAdjacent[int, int](src, func(v int) int { return v }))
+	if !errors.Is(err, boom) {
+		t.Fatalf("got err %v, want %v", err, boom)
+	}
+	want := []Pair[int, []int]{
+		{Key: 1, Value: []int{1, 1}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
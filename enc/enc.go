@@ -0,0 +1,152 @@
+// Package enc provides Iterator sources and sinks for line-delimited and
+// JSON-stream encodings, so this module can sit directly on top of an
+// io.Reader/io.Writer without the caller hand-rolling a scanner or decoder
+// loop. Combined with iterator.MapAsync and iterator.Filter, it is enough to
+// build a streaming ETL pipeline instead of only operating on in-memory
+// slices.
+package enc
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/ruesier/iterator"
+)
+
+type lineIter struct {
+	scanner *bufio.Scanner
+	err     error
+}
+
+// LineOption configures the bufio.Scanner backing a NewLineIterator.
+type LineOption func(*bufio.Scanner)
+
+// WithMaxTokenSize overrides bufio.MaxScanTokenSize for a NewLineIterator,
+// for sources with lines longer than the scanner's default limit.
+func WithMaxTokenSize(max int) LineOption {
+	return func(s *bufio.Scanner) {
+		s.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), max)
+	}
+}
+
+// NewLineIterator returns an Iterator over the lines of r, split the same
+// way as bufio.Scanner's default ScanLines split function. Any error from
+// the scanner, including bufio.ErrTooLong for a line past the configured
+// limit, surfaces from Err and stops iteration.
+func NewLineIterator(r io.Reader, opts ...LineOption) iterator.Iterator[string] {
+	s := bufio.NewScanner(r)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return &lineIter{scanner: s}
+}
+
+func (l *lineIter) Next() bool {
+	if l.err != nil {
+		return false
+	}
+	if l.scanner.Scan() {
+		return true
+	}
+	l.err = l.scanner.Err()
+	return false
+}
+
+func (l *lineIter) Get() string {
+	return l.scanner.Text()
+}
+
+func (l *lineIter) Err() error {
+	return l.err
+}
+
+type jsonStreamIter[T any] struct {
+	dec       *json.Decoder
+	arrayMode bool
+	done      bool
+	cur       T
+	err       error
+}
+
+// NewJSONStreamIterator returns an Iterator over the JSON values read from
+// r, accepting either newline-delimited JSON (a value per line, or more
+// generally concatenated top-level values) or a single top-level JSON
+// array. The two are told apart by the first non-whitespace byte in r.
+// A decode error, including a short or malformed trailing value, surfaces
+// from Err and stops iteration.
+func NewJSONStreamIterator[T any](r io.Reader) iterator.Iterator[T] {
+	br := bufio.NewReader(r)
+	j := &jsonStreamIter[T]{arrayMode: peekArrayOpen(br)}
+	j.dec = json.NewDecoder(br)
+	if j.arrayMode {
+		if _, err := j.dec.Token(); err != nil {
+			j.err = err
+			j.done = true
+		}
+	}
+	return j
+}
+
+// peekArrayOpen reports whether the first non-whitespace byte available
+// from br is '[', without consuming it when it is not.
+func peekArrayOpen(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\n', '\r':
+			br.Discard(1)
+		case '[':
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+func (j *jsonStreamIter[T]) Next() bool {
+	if j.done {
+		return false
+	}
+	if j.arrayMode && !j.dec.More() {
+		if _, err := j.dec.Token(); err != nil {
+			j.err = err
+		}
+		j.done = true
+		return false
+	}
+	var v T
+	if err := j.dec.Decode(&v); err != nil {
+		if err != io.EOF {
+			j.err = err
+		}
+		j.done = true
+		return false
+	}
+	j.cur = v
+	return true
+}
+
+func (j *jsonStreamIter[T]) Get() T {
+	return j.cur
+}
+
+func (j *jsonStreamIter[T]) Err() error {
+	return j.err
+}
+
+// WriteJSONLines drains iter, writing each element to w as its own line of
+// JSON. It stops and returns the first error encountered, whether from
+// encoding a value or from iter itself.
+func WriteJSONLines[T any](iter iterator.Iterator[T], w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for iter.Next() {
+		if err := enc.Encode(iter.Get()); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
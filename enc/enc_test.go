@@ -0,0 +1,82 @@
+package enc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ruesier/iterator"
+)
+
+func TestNewLineIterator(t *testing.T) {
+	r := strings.NewReader("a\nb\nc")
+	got, err := iterator.ToSlice[string](NewLineIterator(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "b", "c"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestNewLineIteratorLineTooLong(t *testing.T) {
+	long := strings.Repeat("a", bufio.MaxScanTokenSize+10)
+	r := strings.NewReader(long + "\nshort")
+	_, err := iterator.ToSlice[string](NewLineIterator(r))
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Fatalf("got err %v, want %v", err, bufio.ErrTooLong)
+	}
+}
+
+func TestNewLineIteratorWithMaxTokenSize(t *testing.T) {
+	long := strings.Repeat("a", bufio.MaxScanTokenSize+10)
+	r := strings.NewReader(long + "\nshort")
+	got, err := iterator.ToSlice[string](NewLineIterator(r, WithMaxTokenSize(bufio.MaxScanTokenSize*2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{long, "short"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestNewJSONStreamIterator(t *testing.T) {
+	t.Run("ndjson", func(t *testing.T) {
+		r := strings.NewReader("1\n2\n3\n")
+		got, err := iterator.ToSlice[int](NewJSONStreamIterator[int](r))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []int{1, 2, 3}); diff != "" {
+			t.Fatalf("mismatch result (-got, +want): %s", diff)
+		}
+	})
+
+	t.Run("array", func(t *testing.T) {
+		r := strings.NewReader("[1, 2, 3]")
+		got, err := iterator.ToSlice[int](NewJSONStreamIterator[int](r))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []int{1, 2, 3}); diff != "" {
+			t.Fatalf("mismatch result (-got, +want): %s", diff)
+		}
+	})
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	src := &iterator.Slice[int]{Slice: []int{1, 2, 3}}
+	var buf bytes.Buffer
+	if err := WriteJSONLines[int](src, &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "1\n2\n3\n"
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
@@ -167,6 +167,14 @@ func (c Combine[BEFORE, AFTER]) Err() error {
 	return nil
 }
 
+// Pair is a generic two-value tuple. It is used by operators that need to
+// associate a value with some derived key, such as a grouping key or the
+// key/value result of a map-shaped iteration.
+type Pair[A any, B any] struct {
+	Key   A
+	Value B
+}
+
 type Error string
 
 func (e Error) Error() string {
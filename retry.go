@@ -0,0 +1,116 @@
+package iterator
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures how Retry and MapAsyncRetry respond to a failure.
+// Attempt n (0-indexed) after the first sleeps for BaseDelay*Factor^n, plus
+// up to Jitter of extra random delay, before trying again.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts allowed, including the
+	// first. A value <= 1 disables retrying.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	Factor      float64
+	Jitter      time.Duration
+
+	// IsRetryable reports whether a given error should be retried. A nil
+	// IsRetryable retries every error.
+	IsRetryable func(error) bool
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	if p.IsRetryable == nil {
+		return true
+	}
+	return p.IsRetryable(err)
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 0; i < attempt; i++ {
+		d = time.Duration(float64(d) * p.Factor)
+	}
+	if p.Jitter > 0 {
+		d += time.Duration(rand.Int63n(int64(p.Jitter)))
+	}
+	return d
+}
+
+type retryIter[E any] struct {
+	src    Iterator[E]
+	policy RetryPolicy
+}
+
+// Retry wraps iter so that a failed Next (one where Next returns false and
+// Err returns non-nil) is not necessarily terminal: the wrapper sleeps
+// according to policy and calls the source's Next again, making at most
+// policy.MaxAttempts calls per failure counting the one that failed, and
+// stopping for good once policy gives up or IsRetryable rejects the error.
+//
+// Retry calling Next again after a failure only does something useful if
+// the iterator itself is re-entrant after an error, i.e. a further Next
+// call can still make progress instead of seeing the same failure (or
+// none at all) forever. No Iterator in this module is re-entrant this
+// way today, including Generator: once its Generate func returns an
+// error, Next keeps reporting that failure and never calls Generate
+// again. Only wrap iterators you control that are documented as
+// re-entrant after an error.
+func Retry[E any](iter Iterator[E], policy RetryPolicy) Iterator[E] {
+	return &retryIter[E]{src: iter, policy: policy}
+}
+
+func (r *retryIter[E]) Next() bool {
+	for attempt := 0; ; attempt++ {
+		if r.src.Next() {
+			return true
+		}
+		err := r.src.Err()
+		if err == nil {
+			return false
+		}
+		if attempt >= r.policy.MaxAttempts-1 || !r.policy.retryable(err) {
+			return false
+		}
+		time.Sleep(r.policy.delay(attempt))
+	}
+}
+
+func (r *retryIter[E]) Get() E {
+	return r.src.Get()
+}
+
+func (r *retryIter[E]) Err() error {
+	return r.src.Err()
+}
+
+// retryUpdate runs update, retrying per policy until it succeeds or policy
+// gives up, and returns the last attempt's result.
+func retryUpdate[BEFORE any, AFTER any](element BEFORE, update func(BEFORE) (AFTER, error), policy RetryPolicy) (AFTER, error) {
+	var (
+		updated AFTER
+		err     error
+	)
+	for attempt := 0; ; attempt++ {
+		updated, err = update(element)
+		if err == nil {
+			return updated, nil
+		}
+		if attempt >= policy.MaxAttempts-1 || !policy.retryable(err) {
+			return updated, err
+		}
+		time.Sleep(policy.delay(attempt))
+	}
+}
+
+// MapAsyncRetry is MapAsync with each worker retrying a failed update call
+// against policy before surfacing the failure. A per-element failure that
+// exhausts policy still terminates the whole iterator, the same as
+// MapAsync: it is only the retrying of update itself that is new.
+func MapAsyncRetry[BEFORE any, AFTER any](iter Iterator[BEFORE], update func(BEFORE) (AFTER, error), n int, policy RetryPolicy) Iterator[AFTER] {
+	return MapAsync(iter, func(element BEFORE) (AFTER, error) {
+		return retryUpdate(element, update, policy)
+	}, n)
+}
@@ -0,0 +1,84 @@
+package iterator
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type flakyIter struct {
+	vals    []int
+	failAt  int
+	attempt int
+	cur     int
+	err     error
+}
+
+func (f *flakyIter) Next() bool {
+	if len(f.vals) == 0 {
+		return false
+	}
+	if f.attempt == f.failAt {
+		f.attempt++
+		f.err = errors.New("flaky")
+		return false
+	}
+	f.attempt++
+	f.err = nil
+	f.cur = f.vals[0]
+	f.vals = f.vals[1:]
+	return true
+}
+
+func (f *flakyIter) Get() int {
+	return f.cur
+}
+
+func (f *flakyIter) Err() error {
+	return f.err
+}
+
+func TestRetry(t *testing.T) {
+	src := &flakyIter{vals: []int{1, 2, 3}, failAt: 1}
+	retried := Retry[int](src, RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond})
+	got, err := ToSlice[int](retried)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{1, 2, 3}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestMapAsyncRetry(t *testing.T) {
+	src := &Slice[int]{Slice: []int{1, 2, 3}}
+
+	var mu sync.Mutex
+	failedOnce := map[int]bool{}
+	update := func(v int) (int, error) {
+		mu.Lock()
+		alreadyFailed := failedOnce[v]
+		failedOnce[v] = true
+		mu.Unlock()
+		if !alreadyFailed {
+			return 0, errors.New("flaky")
+		}
+		return v * 10, nil
+	}
+
+	mapped := MapAsyncRetry[int, int](src, update, 2, RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond})
+	got, err := ToSlice[int](mapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Ints(got)
+	want := []int{10, 20, 30}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
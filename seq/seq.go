@@ -0,0 +1,127 @@
+// Package seq provides adapters between this module's Iterator[E] and the
+// standard library's range-over-func iterators, iter.Seq[E] and
+// iter.Seq2[K, V]. It lets producers from packages such as slices and maps
+// feed into Filter, Map, Limit, MapAsync and friends, and lets the result of
+// an Iterator pipeline be ranged over directly with `for range`.
+package seq
+
+import (
+	"iter"
+
+	"github.com/ruesier/iterator"
+)
+
+// ToSeq adapts an Iterator into an iter.Seq, suitable for use in a
+// `for range` statement. Iteration stops early if the range body breaks,
+// without draining the remainder of iter. Any error from iter.Err() is
+// dropped; use ToSeq2Err to observe it.
+func ToSeq[E any](iter_ iterator.Iterator[E]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for iter_.Next() {
+			if !yield(iter_.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2Err adapts an Iterator into an iter.Seq2 of (value, error) pairs.
+// Every yielded value during normal iteration carries a nil error. If the
+// Iterator stops because of an error, that error is yielded once as a final
+// pair alongside the zero value of E.
+func ToSeq2Err[E any](iter_ iterator.Iterator[E]) iter.Seq2[E, error] {
+	return func(yield func(E, error) bool) {
+		for iter_.Next() {
+			if !yield(iter_.Get(), nil) {
+				return
+			}
+		}
+		if err := iter_.Err(); err != nil {
+			var zero E
+			yield(zero, err)
+		}
+	}
+}
+
+// pullIter is an Iterator backed by the pull-style next/stop pair produced by
+// iter.Pull. It never errors: iter.Seq has no way to report one.
+type pullIter[E any] struct {
+	next func() (E, bool)
+	stop func()
+
+	cur  E
+	done bool
+}
+
+// FromSeq adapts an iter.Seq into an Iterator, so that range-over-func
+// producers like slices.Values or maps.Keys can be piped through Filter,
+// Map, Limit, MapAsync and the rest of this package. The returned Iterator
+// must be fully drained or have its underlying goroutine released by
+// reaching the end of seq; callers that abandon the Iterator early should be
+// aware iter.Pull leaves that goroutine parked until then.
+func FromSeq[E any](seq iter.Seq[E]) iterator.Iterator[E] {
+	next, stop := iter.Pull(seq)
+	return &pullIter[E]{next: next, stop: stop}
+}
+
+func (p *pullIter[E]) Next() bool {
+	if p.done {
+		return false
+	}
+	v, ok := p.next()
+	if !ok {
+		p.done = true
+		p.stop()
+		return false
+	}
+	p.cur = v
+	return true
+}
+
+func (p *pullIter[E]) Get() E {
+	return p.cur
+}
+
+func (p *pullIter[E]) Err() error {
+	return nil
+}
+
+// pull2Iter is the iter.Seq2 counterpart of pullIter, wrapping each (K, V)
+// pair into a Pair[K, V].
+type pull2Iter[K, V any] struct {
+	next func() (K, V, bool)
+	stop func()
+
+	cur  iterator.Pair[K, V]
+	done bool
+}
+
+// FromSeq2 adapts an iter.Seq2 into an Iterator of Pair, so that
+// range-over-func producers like maps.All can be piped through this
+// package's combinators. See FromSeq for the caveat about draining.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) iterator.Iterator[iterator.Pair[K, V]] {
+	next, stop := iter.Pull2(seq)
+	return &pull2Iter[K, V]{next: next, stop: stop}
+}
+
+func (p *pull2Iter[K, V]) Next() bool {
+	if p.done {
+		return false
+	}
+	k, v, ok := p.next()
+	if !ok {
+		p.done = true
+		p.stop()
+		return false
+	}
+	p.cur = iterator.Pair[K, V]{Key: k, Value: v}
+	return true
+}
+
+func (p *pull2Iter[K, V]) Get() iterator.Pair[K, V] {
+	return p.cur
+}
+
+func (p *pull2Iter[K, V]) Err() error {
+	return nil
+}
@@ -0,0 +1,44 @@
+package seq
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ruesier/iterator"
+)
+
+func TestToSeqFromSeq(t *testing.T) {
+	src := &iterator.Slice[int]{Slice: []int{1, 2, 3, 4}}
+	var got []int
+	for v := range ToSeq[int](src) {
+		got = append(got, v)
+	}
+	want := []int{1, 2, 3, 4}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+
+	back := FromSeq[int](slices.Values(want))
+	round, err := iterator.ToSlice(back)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(round, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	m := map[string]int{"a": 1}
+	iter := FromSeq2[string, int](maps.All(m))
+	got, err := iterator.ToSlice(iter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []iterator.Pair[string, int]{{Key: "a", Value: 1}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("mismatch result (-got, +want): %s", diff)
+	}
+}